@@ -0,0 +1,57 @@
+package termfields
+
+import "github.com/gdamore/tcell/v2"
+
+// MouseButton identifies the mouse button(s) reported by a mouse event.
+type MouseButton = tcell.ButtonMask
+
+// fieldRegistry holds every live field, in creation order, so a mouse
+// event can be resolved to the field it landed on. NewField appends to
+// it; Destroy removes from it.
+var fieldRegistry []*Field
+
+// Fields returns every field currently registered with the package.
+func Fields() []*Field {
+	fields := make([]*Field, len(fieldRegistry))
+	copy(fields, fieldRegistry)
+	return fields
+}
+
+// Dispatch resolves a mouse event to the field whose bounding box
+// contains it and, if the field has a click callback registered via
+// OnClick, invokes it. Events that are not mouse events, or that land
+// outside every field, are ignored.
+func Dispatch(ev Event) {
+	me, ok := ev.(*tcell.EventMouse)
+	if !ok {
+		return
+	}
+	mx, my := me.Position()
+	for _, f := range fieldRegistry {
+		if f.onClick == nil {
+			continue
+		}
+		if mx >= f.x-1 && mx <= f.x+f.len+1 && my >= f.y-1 && my <= f.y+1 {
+			f.onClick(f, me.Buttons())
+			return
+		}
+	}
+}
+
+// OnClick registers a callback invoked by Dispatch when a mouse event
+// lands within the field's bounding box.
+func (f *Field) OnClick(cb func(*Field, MouseButton)) {
+	f.onClick = cb
+}
+
+// Destroy removes the field from the package-level registry so it is no
+// longer considered by Dispatch. It does not clear the field from the
+// screen.
+func (f *Field) Destroy() {
+	for i, reg := range fieldRegistry {
+		if reg == f {
+			fieldRegistry = append(fieldRegistry[:i], fieldRegistry[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package termfields
+
+import (
+	"errors"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// focusColor is the border color applied to the field that currently has
+// focus within a Form.
+const focusColor = tcell.ColorYellow
+
+// formField pairs a Field with the label drawn to its left and the
+// in-progress edit buffer Run drives while the field has focus.
+type formField struct {
+	field *Field
+	label string
+	edit  *editState
+}
+
+// Form is a container of fields that manages focus and keyboard navigation
+// between them, the natural layer above the single-field API for building
+// compound widgets.
+type Form struct {
+	fields   []*formField
+	focus    int
+	onSubmit func(*Form)
+	onCancel func(*Form)
+}
+
+// NewForm creates an empty Form. Fields are added to it with AddField.
+func NewForm() *Form {
+	return &Form{}
+}
+
+// AddField adds f to the form with the given label, drawn immediately to
+// the left of the field.
+func (form *Form) AddField(f *Field, label string) {
+	form.fields = append(form.fields, &formField{field: f, label: label})
+	form.drawLabel(len(form.fields) - 1)
+}
+
+// OnSubmit registers a callback invoked when the user presses Enter while
+// the form is running.
+func (form *Form) OnSubmit(cb func(*Form)) {
+	form.onSubmit = cb
+}
+
+// OnCancel registers a callback invoked when the user presses Esc while
+// the form is running.
+func (form *Form) OnCancel(cb func(*Form)) {
+	form.onCancel = cb
+}
+
+// Field returns the field at index i, or nil if i is out of range. It is
+// typically used from OnSubmit/OnCancel callbacks to read back values.
+func (form *Form) Field(i int) *Field {
+	if i < 0 || i >= len(form.fields) {
+		return nil
+	}
+	return form.fields[i].field
+}
+
+// Run owns the event loop, tracking a focused field and routing
+// Tab/Shift-Tab/Up/Down between fields, Enter to submit, and every other
+// key (printable runes, Left/Right/Home/End, Backspace/Delete) into the
+// focused field's text, until Enter or Esc is pressed (firing OnSubmit or
+// OnCancel, if registered) or the screen is closed.
+//
+// Run reads from the same event stream as the package-level PollEvent
+// and Field.ReadInput; see PollEvent's doc comment. In particular, don't
+// call a focused field's ReadInput while its Form is running — Run
+// already drives that field's editing for you.
+func (form *Form) Run() error {
+	if len(form.fields) == 0 {
+		return errors.New("termfields: form has no fields")
+	}
+	form.focusField(0)
+
+	for {
+		ev := PollEvent()
+		if ev == nil {
+			return nil
+		}
+		key, ok := ev.(*tcell.EventKey)
+		if !ok {
+			continue
+		}
+		switch key.Key() {
+		case tcell.KeyTab, tcell.KeyDown:
+			form.focusField((form.focus + 1) % len(form.fields))
+		case tcell.KeyBacktab, tcell.KeyUp:
+			form.focusField((form.focus - 1 + len(form.fields)) % len(form.fields))
+		case tcell.KeyEnter:
+			if form.onSubmit != nil {
+				form.onSubmit(form)
+			}
+			return nil
+		case tcell.KeyEsc:
+			if form.onCancel != nil {
+				form.onCancel(form)
+			}
+			return nil
+		default:
+			form.fields[form.focus].edit.handleKey(key)
+		}
+	}
+}
+
+// focusField moves focus to the field at index i, redrawing the
+// previously and newly focused borders and starting a fresh edit
+// buffer, seeded from the field's current text, for the newly focused
+// field.
+func (form *Form) focusField(i int) {
+	if form.focus >= 0 && form.focus < len(form.fields) {
+		form.fields[form.focus].field.SetBorderColors(tcell.ColorDefault, tcell.ColorDefault)
+	}
+	form.focus = i
+	form.fields[i].field.SetBorderColors(focusColor, tcell.ColorDefault)
+	form.fields[i].edit = newEditState(&form.fields[i].field.field)
+}
+
+// drawLabel renders a field's label to the left of its border.
+func (form *Form) drawLabel(i int) {
+	if screen == nil {
+		return
+	}
+	ff := form.fields[i]
+	x := ff.field.Column() - len(ff.label) - 2
+	y := ff.field.Row()
+	for j, c := range ff.label {
+		screen.SetContent(x+j, y, c, nil, tcell.StyleDefault)
+	}
+	screen.Show()
+}
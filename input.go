@@ -0,0 +1,184 @@
+package termfields
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// ErrClosed is returned by ReadInput when the screen is closed while the
+// field is still reading input.
+var ErrClosed = errors.New("termfields: screen closed while reading input")
+
+// ReadInput turns the field into an active text-entry widget: it shows the
+// terminal cursor at the current column, consumes key events from a poll
+// loop, and supports left/right arrow navigation within the field's width,
+// Home/End, backspace/delete, printable-rune insertion, and Enter/Esc to
+// return. It blocks until the user presses Enter or Esc, ctx is canceled,
+// or the screen is closed.
+//
+// ReadInput reads from the same event stream as the package-level
+// PollEvent and Form.Run: only one of them may be draining events at a
+// time. Don't call ReadInput while another goroutine is polling events
+// directly or while a Form owning this field is running — both would
+// race for the same events and each would silently steal events meant
+// for the other.
+func (f *field) ReadInput(ctx context.Context) (string, error) {
+	if screen == nil {
+		return "", errors.New("Term not Initialized")
+	}
+
+	es := newEditState(f)
+
+	events := make(chan tcell.Event)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			ev := PollEvent()
+			if ev == nil {
+				close(events)
+				return
+			}
+			select {
+			case events <- ev:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			screen.HideCursor()
+			return es.text(), ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				return es.text(), ErrClosed
+			}
+			key, ok := ev.(*tcell.EventKey)
+			if !ok {
+				continue
+			}
+			switch key.Key() {
+			case tcell.KeyEnter:
+				screen.HideCursor()
+				return es.text(), nil
+			case tcell.KeyEsc:
+				screen.HideCursor()
+				return es.text(), nil
+			default:
+				es.handleKey(key)
+			}
+		}
+	}
+}
+
+// editState is the cursor and buffer backing an in-progress edit of a
+// field, shared by ReadInput and Form.Run so both drive the exact same
+// navigation and editing rules.
+type editState struct {
+	f     *field
+	runes []rune
+	idx   int
+}
+
+// newEditState seeds an editState from f's current text, with the cursor
+// at the end, and draws it.
+func newEditState(f *field) *editState {
+	runes := []rune(f.text)
+	for displayWidth(runes, f.masked) > f.len {
+		runes = runes[:len(runes)-1]
+	}
+	es := &editState{f: f, runes: runes, idx: len(runes)}
+	es.redraw()
+	return es
+}
+
+// text returns the buffer's current contents.
+func (es *editState) text() string {
+	return string(es.runes)
+}
+
+// handleKey applies a navigation or editing key to the buffer and
+// redraws it. It reports whether the key was recognized as an edit key;
+// an unrecognized key leaves the buffer as it is and is not redrawn.
+// ReadInput and Form.Run handle their own keys before calling it.
+func (es *editState) handleKey(key *tcell.EventKey) bool {
+	f := es.f
+	switch key.Key() {
+	case tcell.KeyLeft:
+		if es.idx > 0 {
+			es.idx--
+		}
+	case tcell.KeyRight:
+		if es.idx < len(es.runes) {
+			es.idx++
+		}
+	case tcell.KeyHome:
+		es.idx = 0
+	case tcell.KeyEnd:
+		es.idx = len(es.runes)
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if es.idx > 0 {
+			es.runes = append(es.runes[:es.idx-1], es.runes[es.idx:]...)
+			es.idx--
+		}
+	case tcell.KeyDelete:
+		if es.idx < len(es.runes) {
+			es.runes = append(es.runes[:es.idx], es.runes[es.idx+1:]...)
+		}
+	case tcell.KeyRune:
+		if displayWidth(es.runes, f.masked)+runeDisplayWidth(key.Rune(), f.masked) <= f.len {
+			es.runes = append(es.runes[:es.idx], append([]rune{key.Rune()}, es.runes[es.idx:]...)...)
+			es.idx++
+		}
+	default:
+		return false
+	}
+	es.redraw()
+	return true
+}
+
+// redraw writes the buffer to the field, blanks any trailing cells the
+// new content no longer reaches, and positions the terminal cursor.
+func (es *editState) redraw() {
+	f := es.f
+	f.Update(string(es.runes))
+	style := tcell.StyleDefault.Foreground(f.fg).Background(f.bg).Attributes(f.attr)
+	for col := displayWidth(es.runes, f.masked); col < f.len; col++ {
+		screen.SetContent(f.x+col, f.y, ' ', nil, style)
+	}
+	screen.ShowCursor(f.x+displayWidth(es.runes[:es.idx], f.masked), f.y)
+	screen.Show()
+}
+
+// displayWidth returns the number of terminal columns runes occupies once
+// rendered, honoring masked fields where every rune displays as a single
+// asterisk regardless of its own width.
+func displayWidth(runes []rune, masked bool) int {
+	if masked {
+		return len(runes)
+	}
+	return runewidth.StringWidth(string(runes))
+}
+
+// runeDisplayWidth is displayWidth for a single rune, matching how it
+// will render once inserted.
+func runeDisplayWidth(r rune, masked bool) int {
+	if masked {
+		return 1
+	}
+	return runewidth.RuneWidth(r)
+}
+
+// SetMasked controls whether the field renders its text as asterisks,
+// for password-style input. It does not affect the value returned by
+// ReadInput, only how it is drawn.
+func (f *field) SetMasked(masked bool) {
+	f.masked = masked
+	f.Update(f.text)
+}
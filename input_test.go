@@ -0,0 +1,23 @@
+package termfields
+
+import "testing"
+
+func TestMaskString(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"ascii", "hunter2", "*******"},
+		{"multi-byte rune masked once", "pâté", "****"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maskString(tt.in); got != tt.want {
+				t.Errorf("maskString(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
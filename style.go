@@ -0,0 +1,72 @@
+package termfields
+
+import "github.com/gdamore/tcell/v2"
+
+// OutputMode controls the color space fields are rendered in, mirroring
+// termbox's OutputMode concept for terminals with limited color support.
+type OutputMode int
+
+// Supported output modes.
+const (
+	OutputMode256 OutputMode = iota
+	OutputModeTrueColor
+)
+
+var outputMode = OutputModeTrueColor
+
+// SetOutputMode sets the color capability fields are rendered with. Use
+// OutputMode256 on terminals without true-color support; colors passed to
+// SetColors and SetBorderColors are then downgraded to the nearest color
+// in the 256-color palette.
+func SetOutputMode(mode OutputMode) {
+	outputMode = mode
+}
+
+// SetColors sets the foreground and background color used to render a
+// field's text. The field is redrawn with the new colors immediately.
+func (f *field) SetColors(fg, bg tcell.Color) {
+	f.fg = downgrade(fg)
+	f.bg = downgrade(bg)
+	f.Update(f.text)
+}
+
+// SetAttr sets the text attributes (bold, underline, reverse, ...) used to
+// render a field's text. The field is redrawn with the new attributes
+// immediately.
+func (f *field) SetAttr(attr tcell.AttrMask) {
+	f.attr = attr
+	f.Update(f.text)
+}
+
+// SetBorderColors sets the foreground and background color used to draw a
+// field's border. The border is redrawn with the new colors immediately.
+func (f *field) SetBorderColors(fg, bg tcell.Color) {
+	f.borderFg = downgrade(fg)
+	f.borderBg = downgrade(bg)
+	f.DrawBox(f.border)
+}
+
+// downgrade maps c into the current output mode's color space, leaving
+// tcell's named and already-indexed colors untouched.
+func downgrade(c tcell.Color) tcell.Color {
+	if outputMode != OutputMode256 || c == tcell.ColorDefault || !c.IsRGB() {
+		return c
+	}
+	r, g, b := c.RGB()
+	best := tcell.Color(0)
+	bestDist := int64(-1)
+	for i := 0; i < 256; i++ {
+		pr, pg, pb := tcell.PaletteColor(i).RGB()
+		dist := sq(r-pr) + sq(g-pg) + sq(b-pb)
+		if bestDist < 0 || dist < bestDist {
+			bestDist = dist
+			best = tcell.PaletteColor(i)
+		}
+	}
+	return best
+}
+
+func sq(n int32) int64 {
+	d := int64(n)
+	return d * d
+}
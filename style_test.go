@@ -0,0 +1,33 @@
+package termfields
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestDowngrade(t *testing.T) {
+	orig := outputMode
+	defer func() { outputMode = orig }()
+
+	tests := []struct {
+		name string
+		mode OutputMode
+		in   tcell.Color
+		want tcell.Color
+	}{
+		{"true color passthrough", OutputModeTrueColor, tcell.NewRGBColor(10, 20, 30), tcell.NewRGBColor(10, 20, 30)},
+		{"default color passthrough", OutputMode256, tcell.ColorDefault, tcell.ColorDefault},
+		{"named color passthrough", OutputMode256, tcell.ColorRed, tcell.ColorRed},
+		{"true color downgraded to nearest palette entry", OutputMode256, tcell.NewRGBColor(255, 0, 0), tcell.PaletteColor(9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputMode = tt.mode
+			if got := downgrade(tt.in); got != tt.want {
+				t.Errorf("downgrade(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,11 +4,16 @@ package termfields
 import (
 	"fmt"
 
-	tb "github.com/nsf/termbox-go"
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
 )
 
 var boxRunesMap map[boxStyle][]rune
 
+// screen is the shared tcell.Screen backing every field. It is created by
+// Init and torn down by Close.
+var screen tcell.Screen
+
 type (
 	boxStyle uint16
 	shiftDir uint16
@@ -21,25 +26,35 @@ const (
 	BoxStyleASCII
 	BoxStyleUnicode
 )
-
+
 // Flags to Shift a field in a specified direction
-const (
-	FieldShiftLeft shiftDir = iota
-	FieldShiftRight
-	FieldShiftUp
+const (
+	FieldShiftLeft shiftDir = iota
+	FieldShiftRight
+	FieldShiftUp
 	FieldShiftDown
 )
 
+// Event is a terminal event (keyboard, mouse, resize, ...) delivered by
+// PollEvent. It is an alias for tcell.Event so callers can type-switch on
+// the concrete tcell event types (tcell.EventKey, tcell.EventMouse, ...).
+type Event = tcell.Event
+
 // Field is the identifier for a specific form field on the screen.
 type Field struct {
 	field
 }
 
 type field struct {
-	x, y   int
-	len    int
-	border boxStyle
-	text   string
+	x, y               int
+	len                int
+	border             boxStyle
+	text               string
+	fg, bg             tcell.Color
+	attr               tcell.AttrMask
+	borderFg, borderBg tcell.Color
+	masked             bool
+	onClick            func(*Field, MouseButton)
 }
 
 func init() {
@@ -61,14 +76,42 @@ func init() {
 //      }
 //      defer termfields.Close()
 func Init() error {
-	return tb.Init()
+	s, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	if err := s.Init(); err != nil {
+		return err
+	}
+	s.EnableMouse()
+	screen = s
+	return nil
 }
 
-// Close Finalizes termbox library, should be called after successful initialization
-// when termbox's functionality isn't required anymore.
+// Close Finalizes the screen, should be called after successful initialization
+// when termfields' functionality isn't required anymore.
 func Close() {
-	tb.SetCursor(0, 0)
-	tb.Close()
+	if screen == nil {
+		return
+	}
+	screen.HideCursor()
+	screen.Fini()
+	screen = nil
+}
+
+// PollEvent waits for and returns the next terminal event, such as a
+// keypress, mouse action, or resize. It blocks until an event is available
+// and returns nil once the screen has been finalized by Close.
+//
+// There is a single underlying event stream per screen. PollEvent,
+// Field.ReadInput, and Form.Run all read from it, so only one of them may
+// be draining events at any given time in a program; reading from more
+// than one concurrently causes events to be stolen from each other.
+func PollEvent() Event {
+	if screen == nil {
+		return nil
+	}
+	return screen.PollEvent()
 }
 
 // Row returns the row of a field.
@@ -113,51 +156,87 @@ func (f *field) Shift(dir shiftDir) {
 // NewField creates a new field at location y,x of lenth len with contents text.
 func NewField(y, x, len int, text string) (*Field, error) {
 	f := field{
-		x:   x,
-		y:   y,
-		len: len,
+		x:        x,
+		y:        y,
+		len:      len,
+		fg:       tcell.ColorDefault,
+		bg:       tcell.ColorDefault,
+		borderFg: tcell.ColorDefault,
+		borderBg: tcell.ColorDefault,
 	}
 	err := f.Update(text)
 	if err != nil {
 		return nil, err
 	}
-	return &Field{f}, nil
+	field := &Field{f}
+	fieldRegistry = append(fieldRegistry, field)
+	return field, nil
 }
 
 func (f *field) DrawBox(boxType boxStyle) error {
-	if !tb.IsInit {
+	style := tcell.StyleDefault.Foreground(f.borderFg).Background(f.borderBg)
+	if err := drawBorder(f.x-1, f.y-1, f.x+f.len+1, f.y+1, boxType, style); err != nil {
+		return err
+	}
+	f.border = boxType
+	return nil
+}
+
+// drawBorder draws a rectangular border of the given boxStyle with corners
+// at (x0,y0) and (x1,y1). Field.DrawBox and textArea.DrawBox both use it,
+// the former with a single-row box and the latter with a multi-row one.
+func drawBorder(x0, y0, x1, y1 int, boxType boxStyle, style tcell.Style) error {
+	if screen == nil {
 		return fmt.Errorf("Term not Initialized")
 	}
-	if _, ok := boxRunesMap[boxType]; !ok {
+	runes, ok := boxRunesMap[boxType]
+	if !ok {
 		return fmt.Errorf("Unknown Box Style")
 	}
 
 	//Draw Corners
-	tb.SetCell(f.x-1, f.y-1, boxRunesMap[boxType][0], tb.ColorDefault, tb.ColorDefault)
-	tb.SetCell(f.x+f.len+1, f.y-1, boxRunesMap[boxType][1], tb.ColorDefault, tb.ColorDefault)
-	tb.SetCell(f.x-1, f.y+1, boxRunesMap[boxType][2], tb.ColorDefault, tb.ColorDefault)
-	tb.SetCell(f.x+f.len+1, f.y+1, boxRunesMap[boxType][3], tb.ColorDefault, tb.ColorDefault)
+	screen.SetContent(x0, y0, runes[0], nil, style)
+	screen.SetContent(x1, y0, runes[1], nil, style)
+	screen.SetContent(x0, y1, runes[2], nil, style)
+	screen.SetContent(x1, y1, runes[3], nil, style)
+	//Draw Top/Bottom
+	for x := x0 + 1; x < x1; x++ {
+		screen.SetContent(x, y0, runes[4], nil, style)
+		screen.SetContent(x, y1, runes[4], nil, style)
+	}
 	//Draw Sides
-	tb.SetCell(f.x-1, f.y, boxRunesMap[boxType][5], tb.ColorDefault, tb.ColorDefault)
-	tb.SetCell(f.x+f.len+1, f.y, boxRunesMap[boxType][5], tb.ColorDefault, tb.ColorDefault)
-	//Draw Top
-	for i := 0; i < f.len+1; i++ {
-		tb.SetCell(f.x+i, f.y-1, boxRunesMap[boxType][4], tb.ColorDefault, tb.ColorDefault)
-		tb.SetCell(f.x+i, f.y+1, boxRunesMap[boxType][4], tb.ColorDefault, tb.ColorDefault)
-	}
-	tb.Flush()
-	f.border = boxType
+	for y := y0 + 1; y < y1; y++ {
+		screen.SetContent(x0, y, runes[5], nil, style)
+		screen.SetContent(x1, y, runes[5], nil, style)
+	}
+	screen.Show()
 	return nil
 }
 
 func (f *field) Update(s string) error {
-	if !tb.IsInit {
+	if screen == nil {
 		return fmt.Errorf("Term not Initialized")
 	}
-	for i, c := range s {
-		tb.SetCell(f.x+i, f.y, c, tb.ColorDefault, tb.ColorDefault)
+	style := tcell.StyleDefault.Foreground(f.fg).Background(f.bg).Attributes(f.attr)
+	display := s
+	if f.masked {
+		display = maskString(s)
+	}
+	col := 0
+	for _, c := range display {
+		screen.SetContent(f.x+col, f.y, c, nil, style)
+		col += runewidth.RuneWidth(c)
 	}
-	tb.Flush()
+	screen.Show()
 	f.text = s
 	return nil
 }
+
+// maskString replaces every rune in s with an asterisk, for masked fields.
+func maskString(s string) string {
+	masked := make([]rune, 0, len(s))
+	for range s {
+		masked = append(masked, '*')
+	}
+	return string(masked)
+}
@@ -0,0 +1,217 @@
+package termfields
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// WrapMode controls how long lines are wrapped within a TextArea.
+type WrapMode int
+
+// Supported wrap modes.
+const (
+	WrapNone WrapMode = iota
+	WrapChar
+	WrapWord
+)
+
+// TextArea is a multi-line, scrolling region of text, the TextArea
+// counterpart to Field.
+type TextArea struct {
+	textArea
+}
+
+type textArea struct {
+	x, y, width, height int
+	border              boxStyle
+	raw                 []string
+	lines               []string
+	scroll              int
+	wrap                WrapMode
+	fg, bg              tcell.Color
+	borderFg, borderBg  tcell.Color
+}
+
+// NewTextArea creates a new scrolling text area at location y,x of the
+// given width and height, populated with text. Long lines are wrapped on
+// word boundaries by default; see SetWrapMode.
+func NewTextArea(y, x, width, height int, text string) (*TextArea, error) {
+	ta := textArea{
+		x:        x,
+		y:        y,
+		width:    width,
+		height:   height,
+		wrap:     WrapWord,
+		fg:       tcell.ColorDefault,
+		bg:       tcell.ColorDefault,
+		borderFg: tcell.ColorDefault,
+		borderBg: tcell.ColorDefault,
+	}
+	if text != "" {
+		ta.raw = strings.Split(text, "\n")
+	}
+	ta.rewrap()
+	if err := ta.DrawBox(BoxStyleUnicode); err != nil {
+		return nil, err
+	}
+	if err := ta.render(); err != nil {
+		return nil, err
+	}
+	return &TextArea{ta}, nil
+}
+
+// AppendLine appends a line of text to the text area and scrolls to show
+// it.
+func (ta *textArea) AppendLine(s string) error {
+	ta.raw = append(ta.raw, s)
+	ta.rewrap()
+	ta.scroll = ta.maxScroll()
+	return ta.render()
+}
+
+// SetWrapMode changes how long lines are wrapped and redraws the text
+// area.
+func (ta *textArea) SetWrapMode(mode WrapMode) error {
+	ta.wrap = mode
+	ta.rewrap()
+	return ta.render()
+}
+
+// ScrollUp scrolls the text area up by one line.
+func (ta *textArea) ScrollUp() error {
+	if ta.scroll > 0 {
+		ta.scroll--
+	}
+	return ta.render()
+}
+
+// ScrollDown scrolls the text area down by one line.
+func (ta *textArea) ScrollDown() error {
+	if ta.scroll < ta.maxScroll() {
+		ta.scroll++
+	}
+	return ta.render()
+}
+
+// DrawBox draws or clears the text area's border.
+func (ta *textArea) DrawBox(boxType boxStyle) error {
+	style := tcell.StyleDefault.Foreground(ta.borderFg).Background(ta.borderBg)
+	if err := drawBorder(ta.x-1, ta.y-1, ta.x+ta.width, ta.y+ta.height, boxType, style); err != nil {
+		return err
+	}
+	ta.border = boxType
+	return nil
+}
+
+func (ta *textArea) maxScroll() int {
+	max := len(ta.lines) - ta.height
+	if max < 0 {
+		max = 0
+	}
+	return max
+}
+
+// rewrap recomputes the wrapped display lines from the raw appended lines.
+func (ta *textArea) rewrap() {
+	ta.lines = ta.lines[:0]
+	for _, line := range ta.raw {
+		ta.lines = append(ta.lines, wrapLine(line, ta.width, ta.wrap)...)
+	}
+}
+
+// render redraws the visible rows of the text area from the current
+// scroll offset.
+func (ta *textArea) render() error {
+	if screen == nil {
+		return fmt.Errorf("Term not Initialized")
+	}
+	style := tcell.StyleDefault.Foreground(ta.fg).Background(ta.bg)
+	for row := 0; row < ta.height; row++ {
+		line := ""
+		if i := ta.scroll + row; i < len(ta.lines) {
+			line = ta.lines[i]
+		}
+		line = runewidth.Truncate(line, ta.width, "")
+		col := 0
+		for _, c := range line {
+			screen.SetContent(ta.x+col, ta.y+row, c, nil, style)
+			col += runewidth.RuneWidth(c)
+		}
+		for ; col < ta.width; col++ {
+			screen.SetContent(ta.x+col, ta.y+row, ' ', nil, style)
+		}
+	}
+	screen.Show()
+	return nil
+}
+
+// wrapLine breaks s into display lines no wider than width, according to
+// mode. With WrapNone, or a width of zero or less, s is returned
+// unbroken as a single line whatever its width.
+func wrapLine(s string, width int, mode WrapMode) []string {
+	if width <= 0 {
+		return []string{s}
+	}
+	switch mode {
+	case WrapChar:
+		return wrapChar(s, width)
+	case WrapWord:
+		return wrapWord(s, width)
+	default:
+		return []string{s}
+	}
+}
+
+func wrapChar(s string, width int) []string {
+	var lines []string
+	cur := strings.Builder{}
+	curWidth := 0
+	for _, r := range s {
+		rw := runewidth.RuneWidth(r)
+		if curWidth+rw > width && cur.Len() > 0 {
+			lines = append(lines, cur.String())
+			cur.Reset()
+			curWidth = 0
+		}
+		cur.WriteRune(r)
+		curWidth += rw
+	}
+	lines = append(lines, cur.String())
+	return lines
+}
+
+func wrapWord(s string, width int) []string {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return []string{""}
+	}
+	var lines []string
+	cur := ""
+	for _, word := range words {
+		if runewidth.StringWidth(word) > width {
+			if cur != "" {
+				lines = append(lines, cur)
+				cur = ""
+			}
+			lines = append(lines, wrapChar(word, width)...)
+			continue
+		}
+		candidate := word
+		if cur != "" {
+			candidate = cur + " " + word
+		}
+		if runewidth.StringWidth(candidate) > width {
+			lines = append(lines, cur)
+			cur = word
+			continue
+		}
+		cur = candidate
+	}
+	if cur != "" {
+		lines = append(lines, cur)
+	}
+	return lines
+}
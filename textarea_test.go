@@ -0,0 +1,49 @@
+package termfields
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrapChar(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  []string
+	}{
+		{"fits on one line", "hi", 5, []string{"hi"}},
+		{"splits on exact width", "abcdef", 3, []string{"abc", "def"}},
+		{"empty input", "", 3, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wrapChar(tt.in, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("wrapChar(%q, %d) = %#v, want %#v", tt.in, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  []string
+	}{
+		{"fits on one line", "hello world", 20, []string{"hello world"}},
+		{"wraps on word boundary", "hello world", 8, []string{"hello", "world"}},
+		{"word longer than width hard-breaks", "supercalifragilistic", 5, []string{"super", "calif", "ragil", "istic"}},
+		{"empty input", "", 5, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wrapWord(tt.in, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("wrapWord(%q, %d) = %#v, want %#v", tt.in, tt.width, got, tt.want)
+			}
+		})
+	}
+}